@@ -27,6 +27,16 @@ func (t WorkflowHookEventType) IsValidForEventName(n WorkflowHookEventName) bool
 		case WorkflowHookEventTypePullRequestCommentCreated, WorkflowHookEventTypePullRequestCommentDeleted, WorkflowHookEventTypePullRequestCommentEdited:
 			return true
 		}
+	case WorkflowHookEventNamePullRequestReview:
+		switch t {
+		case WorkflowHookEventTypePullRequestReviewSubmitted, WorkflowHookEventTypePullRequestReviewEdited, WorkflowHookEventTypePullRequestReviewDismissed:
+			return true
+		}
+	case WorkflowHookEventNamePullRequestReviewComment:
+		switch t {
+		case WorkflowHookEventTypePullRequestReviewCommentCreated, WorkflowHookEventTypePullRequestReviewCommentEdited, WorkflowHookEventTypePullRequestReviewCommentDeleted:
+			return true
+		}
 	default:
 		return t == ""
 	}
@@ -34,10 +44,11 @@ func (t WorkflowHookEventType) IsValidForEventName(n WorkflowHookEventName) bool
 }
 
 const (
-	SignHeaderVCSName   = "X-Cds-Hooks-Vcs-Name"
-	SignHeaderRepoName  = "X-Cds-Hooks-Repo-Name"
-	SignHeaderVCSType   = "X-Cds-Hooks-Vcs-Type"
-	SignHeaderEventName = "X-Cds-Hooks-Event-Name"
+	SignHeaderVCSName           = "X-Cds-Hooks-Vcs-Name"
+	SignHeaderRepoName          = "X-Cds-Hooks-Repo-Name"
+	SignHeaderVCSType           = "X-Cds-Hooks-Vcs-Type"
+	SignHeaderEventName         = "X-Cds-Hooks-Event-Name"
+	SignHeaderProviderSignature = "X-Cds-Hooks-Provider-Signature"
 
 	WorkflowHookEventNameWorkflowUpdate WorkflowHookEventName = "workflow-update"
 	WorkflowHookEventNameModelUpdate    WorkflowHookEventName = "model-update"
@@ -58,6 +69,16 @@ const (
 	WorkflowHookEventTypePullRequestCommentDeleted WorkflowHookEventType = "deleted"
 	WorkflowHookEventTypePullRequestCommentEdited  WorkflowHookEventType = "edited"
 
+	WorkflowHookEventNamePullRequestReview          WorkflowHookEventName = "pull-request-review"
+	WorkflowHookEventTypePullRequestReviewSubmitted WorkflowHookEventType = "submitted"
+	WorkflowHookEventTypePullRequestReviewEdited    WorkflowHookEventType = "edited"
+	WorkflowHookEventTypePullRequestReviewDismissed WorkflowHookEventType = "dismissed"
+
+	WorkflowHookEventNamePullRequestReviewComment        WorkflowHookEventName = "pull-request-review-comment"
+	WorkflowHookEventTypePullRequestReviewCommentCreated WorkflowHookEventType = "created"
+	WorkflowHookEventTypePullRequestReviewCommentEdited  WorkflowHookEventType = "edited"
+	WorkflowHookEventTypePullRequestReviewCommentDeleted WorkflowHookEventType = "deleted"
+
 	RepoEventPush = "push"
 
 	HookEventStatusScheduled     = "Scheduled"
@@ -70,6 +91,8 @@ const (
 	HookEventStatusDone          = "Done"
 	HookEventStatusError         = "Error"
 	HookEventStatusSkipped       = "Skipped"
+	HookEventStatusScheduleSync  = "ScheduleSync"
+	HookEventStatusDeadLettered  = "DeadLettered"
 
 	HookEventWorkflowStatusScheduled = "Scheduled"
 	HookEventWorkflowStatusSkipped   = "Skipped"
@@ -115,6 +138,7 @@ type HookWorkflowRunOutgoingEvent struct {
 	Status              string               `json:"status"`
 	LastError           string               `json:"last_error"`
 	NbErrors            int64                `json:"nb_errors"`
+	NextRetryAt         int64                `json:"next_retry_at,omitempty"`
 	HooksToTriggers     []HookWorkflowRunOutgoingEventHooks
 }
 
@@ -143,6 +167,7 @@ type HookRepositoryEvent struct {
 	LastUpdate                int64                          `json:"last_update"`
 	LastError                 string                         `json:"last_error"`
 	NbErrors                  int64                          `json:"nb_errors"`
+	NextRetryAt               int64                          `json:"next_retry_at,omitempty"`
 	Analyses                  []HookRepositoryEventAnalysis  `json:"analyses"`
 	ModelUpdated              []EntityFullName               `json:"model_updated"`
 	WorkflowUpdated           []EntityFullName               `json:"workflow_updated"`
@@ -161,6 +186,13 @@ func (h *HookRepositoryEvent) IsTerminated() bool {
 	return h.Status == HookEventStatusDone || h.Status == HookEventStatusError || h.Status == HookEventStatusSkipped
 }
 
+func analysisStatusText(a HookRepositoryEventAnalysis) string {
+	if a.SourceDir == "" {
+		return a.Status
+	}
+	return fmt.Sprintf("%s (%s)", a.Status, a.SourceDir)
+}
+
 func (h *HookRepositoryEvent) ToInsightReport(uiURL string) VCSInsight {
 	report := VCSInsight{
 		Title:  "CDS",
@@ -183,7 +215,7 @@ func (h *HookRepositoryEvent) ToInsightReport(uiURL string) VCSInsight {
 			report.Datas = append(report.Datas, VCSInsightData{
 				Title: "Analysis on " + a.ProjectKey,
 				Type:  "LINK",
-				Text:  a.Status,
+				Text:  analysisStatusText(a),
 				Href:  fmt.Sprintf("%s/project/%s/explore/vcs/%s/repository/%s/settings", uiURL, a.ProjectKey, h.VCSServerName, url.PathEscape(h.RepositoryName)),
 			})
 		}
@@ -194,7 +226,7 @@ func (h *HookRepositoryEvent) ToInsightReport(uiURL string) VCSInsight {
 		report.Datas = append(report.Datas, VCSInsightData{
 			Title: "Analysis on " + a.ProjectKey,
 			Type:  "LINK",
-			Text:  a.Status,
+			Text:  analysisStatusText(a),
 			Href:  fmt.Sprintf("%s/project/%s/explore/vcs/%s/repository/%s/settings", uiURL, a.ProjectKey, h.VCSServerName, url.PathEscape(h.RepositoryName)),
 		})
 		// If no analysis
@@ -252,6 +284,11 @@ type HookRepositoryEventWorkflow struct {
 	TargetCommit         string             `json:"target_commit,omitempty"`
 	ModelFullName        string             `json:"model,omitempty"`
 	PathFilters          []string           `json:"path_filters,omitempty"`
+	PathsIgnore          []string           `json:"paths_ignore,omitempty"`
+	Branches             []string           `json:"branches,omitempty"`
+	BranchesIgnore       []string           `json:"branches_ignore,omitempty"`
+	Tags                 []string           `json:"tags,omitempty"`
+	TagsIgnore           []string           `json:"tags_ignore,omitempty"`
 	Data                 V2WorkflowHookData `json:"data,omitempty"`
 	Initiator            *V2Initiator       `json:"initiator,omitempty"`
 
@@ -287,6 +324,7 @@ type HookRepositoryEventExtractData struct {
 	Ref                string                                      `json:"ref"`
 	PullRequestID      int64                                       `json:"pullrequest_id"`
 	PullRequestRefTo   string                                      `json:"pullrequest_ref_to"`
+	PullRequestReview  *HookRepositoryEventExtractedDataReview     `json:"pullrequest_review,omitempty"`
 	Manual             HookRepositoryEventExtractedDataManual      `json:"manual"`
 	DeprecatedAdminMFA bool                                        `json:"admin_mfa"` // Deprecated
 	Scheduler          HookRepositoryEventExtractedDataScheduler   `json:"scheduler"`
@@ -295,6 +333,20 @@ type HookRepositoryEventExtractData struct {
 	HookProjectKey     string                                      `json:"hook_project_key"` // force the hook to only trigger from the given CDS project
 }
 
+// HookRepositoryEventExtractedDataReview carries the review state, reviewer,
+// reviewed commit and (for review-comment events) comment id/body for
+// WorkflowHookEventNamePullRequestReview and
+// WorkflowHookEventNamePullRequestReviewComment events. No VCS driver in this
+// slice of the codebase populates it yet; HookRepositoryEventExtractData.PullRequestReview
+// stays nil until the GitHub/GitLab/Gitea/Bitbucket payload parsing is wired up.
+type HookRepositoryEventExtractedDataReview struct {
+	State          string `json:"state"`
+	Reviewer       string `json:"reviewer"`
+	ReviewedCommit string `json:"reviewed_commit"`
+	CommentID      string `json:"comment_id,omitempty"`
+	CommentBody    string `json:"comment_body,omitempty"`
+}
+
 type HookRepositoryEventExtractedDataWebHook struct {
 	Project    string `json:"project"`
 	VCS        string `json:"vcs"`
@@ -329,6 +381,13 @@ type HookRepositoryEventExtractedDataScheduler struct {
 	Timezone       string `json:"timezone"`
 }
 
+// WorkflowScheduleTrigger is one entry of a workflow's `on.schedule` matrix,
+// as declared in a `.cds/workflows/*.yml` file.
+type WorkflowScheduleTrigger struct {
+	Cron     string `json:"cron" yaml:"cron" cli:"cron"`
+	Timezone string `json:"timezone,omitempty" yaml:"timezone,omitempty" cli:"timezone"`
+}
+
 type GeneratedWebhook struct {
 	Key           string `json:"key"`
 	UUID          string `json:"uuid"`
@@ -345,6 +404,7 @@ type HookRepositoryEventAnalysis struct {
 	ProjectKey     string `json:"project_key"`
 	Error          string `json:"error"`
 	FindRetryCount int64  `json:"find_retry_count"`
+	SourceDir      string `json:"source_dir,omitempty"`
 }
 
 type HookRetrieveSignKeyRequest struct {
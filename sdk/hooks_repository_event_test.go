@@ -0,0 +1,31 @@
+package sdk
+
+import "testing"
+
+func TestIsValidForEventName(t *testing.T) {
+	testCases := []struct {
+		name      WorkflowHookEventName
+		eventType WorkflowHookEventType
+		valid     bool
+	}{
+		{WorkflowHookEventNamePullRequestReview, WorkflowHookEventTypePullRequestReviewSubmitted, true},
+		{WorkflowHookEventNamePullRequestReview, WorkflowHookEventTypePullRequestReviewEdited, true},
+		{WorkflowHookEventNamePullRequestReview, WorkflowHookEventTypePullRequestReviewDismissed, true},
+		{WorkflowHookEventNamePullRequestReviewComment, WorkflowHookEventTypePullRequestReviewCommentCreated, true},
+		{WorkflowHookEventNamePullRequestReviewComment, WorkflowHookEventTypePullRequestReviewCommentEdited, true},
+		{WorkflowHookEventNamePullRequestReviewComment, WorkflowHookEventTypePullRequestReviewCommentDeleted, true},
+		// a review-comment type is not valid for the plain review event name...
+		{WorkflowHookEventNamePullRequestReview, WorkflowHookEventTypePullRequestReviewCommentCreated, false},
+		// ...and vice versa.
+		{WorkflowHookEventNamePullRequestReviewComment, WorkflowHookEventTypePullRequestReviewSubmitted, false},
+		{WorkflowHookEventNamePullRequestReview, WorkflowHookEventTypePullRequestOpened, false},
+		{WorkflowHookEventNamePullRequestReview, "", false},
+		{WorkflowHookEventNamePullRequestReviewComment, "", false},
+	}
+
+	for _, tc := range testCases {
+		if got := tc.eventType.IsValidForEventName(tc.name); got != tc.valid {
+			t.Errorf("IsValidForEventName(%q, %q) = %v, want %v", tc.name, tc.eventType, got, tc.valid)
+		}
+	}
+}
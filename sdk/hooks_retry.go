@@ -0,0 +1,99 @@
+package sdk
+
+import (
+	"errors"
+	"math"
+	"math/rand"
+	"net"
+	"strings"
+	"time"
+)
+
+// HookRetryPolicy configures the exponential backoff applied to a
+// HookRepositoryEvent or HookWorkflowRunOutgoingEvent between delivery
+// attempts. Once NbErrors reaches MaxAttempts the event transitions to
+// HookEventStatusDeadLettered instead of being rescheduled.
+type HookRetryPolicy struct {
+	MaxAttempts    int64         `json:"max_attempts"`
+	InitialBackoff time.Duration `json:"initial_backoff"`
+	MaxBackoff     time.Duration `json:"max_backoff"`
+	Multiplier     float64       `json:"multiplier"`
+	Jitter         bool          `json:"jitter"`
+}
+
+// DefaultHookRetryPolicy is applied when no per-event override is configured.
+var DefaultHookRetryPolicy = HookRetryPolicy{
+	MaxAttempts:    10,
+	InitialBackoff: 5 * time.Second,
+	MaxBackoff:     30 * time.Minute,
+	Multiplier:     2,
+	Jitter:         true,
+}
+
+// NextRetryAt computes the next attempt timestamp for an event that has
+// already failed nbErrors times: min(MaxBackoff, InitialBackoff*Multiplier^nbErrors)
+// plus, when Jitter is set, a uniform jitter in [0, backoff/2).
+func (p HookRetryPolicy) NextRetryAt(nbErrors int64, now time.Time) time.Time {
+	backoff := p.MaxBackoff
+	if f := float64(p.InitialBackoff) * math.Pow(p.Multiplier, float64(nbErrors)); f < float64(p.MaxBackoff) {
+		backoff = time.Duration(f)
+	}
+	if half := int64(backoff) / 2; p.Jitter && half > 0 {
+		backoff += time.Duration(rand.Int63n(half))
+	}
+	return now.Add(backoff)
+}
+
+// IsDeadLettered reports whether an event that has failed nbErrors times has
+// exhausted its retry budget under this policy.
+func (p HookRetryPolicy) IsDeadLettered(nbErrors int64) bool {
+	return nbErrors >= p.MaxAttempts
+}
+
+// ErrNotDeadLettered is returned by RedriveHookRepositoryEvent when asked to
+// redrive an event that isn't currently HookEventStatusDeadLettered.
+var ErrNotDeadLettered = errors.New("hook event is not dead-lettered")
+
+// RedriveHookRepositoryEvent is the operation the admin re-drive endpoint
+// (POST /admin/hooks/events/{uuid}/redrive) performs: it resets NbErrors and
+// LastError, moves the event back to HookEventStatusScheduled and schedules
+// an immediate NextRetryAt, so the next tick of the hooks worker picks it up
+// as if it had never failed. It refuses to redrive an event that isn't
+// dead-lettered, since those are already retrying on their own schedule.
+func RedriveHookRepositoryEvent(h *HookRepositoryEvent, now time.Time) error {
+	if h.Status != HookEventStatusDeadLettered {
+		return ErrNotDeadLettered
+	}
+	h.NbErrors = 0
+	h.LastError = ""
+	h.Status = HookEventStatusScheduled
+	h.NextRetryAt = now.Unix()
+	h.LastUpdate = now.Unix()
+	return nil
+}
+
+// IsRetryable classifies err as transient (network error, 5xx, an Operation
+// still pending) versus terminal (signature invalid, workflow parse error),
+// so callers can avoid retrying poison messages until they hit MaxAttempts.
+func IsRetryable(err error) bool {
+	if err == nil {
+		return false
+	}
+	if errors.Is(err, ErrSignatureMismatch) {
+		return false
+	}
+	var netErr net.Error
+	if errors.As(err, &netErr) {
+		return true
+	}
+	msg := strings.ToLower(err.Error())
+	switch {
+	case strings.Contains(msg, "operation-pending"), strings.Contains(msg, "operation pending"):
+		return true
+	case strings.Contains(msg, "timeout"), strings.Contains(msg, "connection reset"), strings.Contains(msg, "eof"):
+		return true
+	case strings.Contains(msg, "parse"), strings.Contains(msg, "invalid signature"), strings.Contains(msg, "unmarshal"):
+		return false
+	}
+	return true
+}
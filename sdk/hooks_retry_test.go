@@ -0,0 +1,75 @@
+package sdk
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestHookRetryPolicy_NextRetryAtCapsAtMaxBackoff(t *testing.T) {
+	policy := HookRetryPolicy{
+		InitialBackoff: time.Second,
+		MaxBackoff:     10 * time.Second,
+		Multiplier:     2,
+		Jitter:         false,
+	}
+	now := time.Unix(1000, 0)
+
+	if got := policy.NextRetryAt(0, now); got.Sub(now) != time.Second {
+		t.Fatalf("expected 1s backoff for nbErrors=0, got %s", got.Sub(now))
+	}
+	if got := policy.NextRetryAt(2, now); got.Sub(now) != 4*time.Second {
+		t.Fatalf("expected 4s backoff for nbErrors=2, got %s", got.Sub(now))
+	}
+	if got := policy.NextRetryAt(10, now); got.Sub(now) != policy.MaxBackoff {
+		t.Fatalf("expected backoff to cap at MaxBackoff, got %s", got.Sub(now))
+	}
+}
+
+func TestHookRetryPolicy_IsDeadLettered(t *testing.T) {
+	policy := HookRetryPolicy{MaxAttempts: 3}
+	if policy.IsDeadLettered(2) {
+		t.Fatal("expected nbErrors below MaxAttempts to not be dead-lettered")
+	}
+	if !policy.IsDeadLettered(3) {
+		t.Fatal("expected nbErrors at MaxAttempts to be dead-lettered")
+	}
+}
+
+func TestIsRetryable(t *testing.T) {
+	if IsRetryable(nil) {
+		t.Fatal("nil error should not be retryable")
+	}
+	if IsRetryable(ErrSignatureMismatch) {
+		t.Fatal("signature mismatch should be terminal")
+	}
+	if IsRetryable(errors.New("failed to unmarshal workflow yaml")) {
+		t.Fatal("workflow parse error should be terminal")
+	}
+	if !IsRetryable(errors.New("dial tcp: i/o timeout")) {
+		t.Fatal("timeout should be retryable")
+	}
+}
+
+func TestRedriveHookRepositoryEvent(t *testing.T) {
+	event := &HookRepositoryEvent{
+		Status:    HookEventStatusDeadLettered,
+		NbErrors:  10,
+		LastError: "too many attempts",
+	}
+	now := time.Unix(2000, 0)
+
+	if err := RedriveHookRepositoryEvent(event, now); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if event.Status != HookEventStatusScheduled || event.NbErrors != 0 || event.LastError != "" {
+		t.Fatalf("expected event to be reset, got %+v", event)
+	}
+	if event.NextRetryAt != now.Unix() {
+		t.Fatalf("expected immediate retry, got %d", event.NextRetryAt)
+	}
+
+	if err := RedriveHookRepositoryEvent(event, now); !errors.Is(err, ErrNotDeadLettered) {
+		t.Fatalf("expected ErrNotDeadLettered for an already-redriven event, got %v", err)
+	}
+}
@@ -0,0 +1,106 @@
+package sdk
+
+import "time"
+
+// WorkflowScheduleIdentity identifies the workflow a declared on.schedule
+// matrix belongs to, so persisted scheduler rows can be matched back to it.
+type WorkflowScheduleIdentity struct {
+	ProjectKey     string
+	VCSServerName  string
+	RepositoryName string
+	WorkflowName   string
+}
+
+func (id WorkflowScheduleIdentity) matches(s HookRepositoryEventExtractedDataScheduler) bool {
+	return id.ProjectKey == s.TargetProject && id.VCSServerName == s.TargetVCS &&
+		id.RepositoryName == s.TargetRepo && id.WorkflowName == s.TargetWorkflow
+}
+
+func scheduleKey(cron, timezone string) string {
+	return cron + "|" + timezone
+}
+
+// DiffSchedules mirrors Forgejo's handleSchedules: it compares a workflow's
+// declared `on.schedule` matrix against the HookRepositoryEvent scheduler
+// rows already persisted for that workflow (identified by identity, status
+// HookEventStatusScheduleSync, EventName scheduler) and reports which
+// declared entries are missing (toCreate) and which persisted rows no
+// longer match any declared entry and should be removed (toDelete).
+func DiffSchedules(identity WorkflowScheduleIdentity, declared []WorkflowScheduleTrigger, existing []HookRepositoryEvent) (toCreate []WorkflowScheduleTrigger, toDelete []HookRepositoryEvent) {
+	existingByKey := make(map[string]HookRepositoryEvent, len(existing))
+	for _, e := range existing {
+		if e.EventName != WorkflowHookEventNameScheduler || e.Status != HookEventStatusScheduleSync {
+			continue
+		}
+		if !identity.matches(e.ExtractData.Scheduler) {
+			continue
+		}
+		existingByKey[scheduleKey(e.ExtractData.Scheduler.Cron, e.ExtractData.Scheduler.Timezone)] = e
+	}
+
+	declaredByKey := make(map[string]WorkflowScheduleTrigger, len(declared))
+	for _, trigger := range declared {
+		declaredByKey[scheduleKey(trigger.Cron, trigger.Timezone)] = trigger
+	}
+
+	for key, trigger := range declaredByKey {
+		if _, ok := existingByKey[key]; !ok {
+			toCreate = append(toCreate, trigger)
+		}
+	}
+
+	for key, e := range existingByKey {
+		if _, ok := declaredByKey[key]; !ok {
+			toDelete = append(toDelete, e)
+		}
+	}
+
+	return toCreate, toDelete
+}
+
+// NewScheduleSyncEvent builds the persisted HookRepositoryEvent registration
+// row for a declared on.schedule entry, as created by DiffSchedules'
+// toCreate results. Its status is HookEventStatusScheduleSync until the
+// scheduler tick loop fires it via NewScheduledFireEvent.
+func NewScheduleSyncEvent(uuid string, identity WorkflowScheduleIdentity, trigger WorkflowScheduleTrigger, now time.Time) HookRepositoryEvent {
+	return HookRepositoryEvent{
+		UUID:           uuid,
+		Created:        now.Unix(),
+		LastUpdate:     now.Unix(),
+		EventName:      WorkflowHookEventNameScheduler,
+		VCSServerName:  identity.VCSServerName,
+		RepositoryName: identity.RepositoryName,
+		Status:         HookEventStatusScheduleSync,
+		ExtractData: HookRepositoryEventExtractData{
+			CDSEventName: WorkflowHookEventNameScheduler,
+			Scheduler: HookRepositoryEventExtractedDataScheduler{
+				TargetVCS:      identity.VCSServerName,
+				TargetRepo:     identity.RepositoryName,
+				TargetWorkflow: identity.WorkflowName,
+				TargetProject:  identity.ProjectKey,
+				Cron:           trigger.Cron,
+				Timezone:       trigger.Timezone,
+			},
+		},
+	}
+}
+
+// NewScheduledFireEvent generates the synthetic HookRepositoryEvent that the
+// tick loop enqueues when a registered schedule comes due: a fresh event
+// with EventName=scheduler carrying the same target identity, so the
+// existing analysis/workflow pipeline handles it like any other trigger.
+func NewScheduledFireEvent(uuid string, sync HookRepositoryEvent, now time.Time) HookRepositoryEvent {
+	return HookRepositoryEvent{
+		UUID:           uuid,
+		Created:        now.Unix(),
+		LastUpdate:     now.Unix(),
+		EventName:      WorkflowHookEventNameScheduler,
+		VCSServerName:  sync.VCSServerName,
+		RepositoryName: sync.RepositoryName,
+		Status:         HookEventStatusScheduled,
+		ExtractData: HookRepositoryEventExtractData{
+			CDSEventName: WorkflowHookEventNameScheduler,
+			Scheduler:    sync.ExtractData.Scheduler,
+		},
+	}
+}
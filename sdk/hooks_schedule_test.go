@@ -0,0 +1,75 @@
+package sdk
+
+import (
+	"testing"
+	"time"
+)
+
+func TestDiffSchedules(t *testing.T) {
+	identity := WorkflowScheduleIdentity{
+		ProjectKey:     "PRJ",
+		VCSServerName:  "github",
+		RepositoryName: "owner/repo",
+		WorkflowName:   "build",
+	}
+
+	kept := NewScheduleSyncEvent("kept-uuid", identity, WorkflowScheduleTrigger{Cron: "*/5 * * * *", Timezone: "UTC"}, time.Unix(0, 0))
+	stale := NewScheduleSyncEvent("stale-uuid", identity, WorkflowScheduleTrigger{Cron: "0 0 * * *", Timezone: "UTC"}, time.Unix(0, 0))
+	other := NewScheduleSyncEvent("other-workflow-uuid", WorkflowScheduleIdentity{
+		ProjectKey: "PRJ", VCSServerName: "github", RepositoryName: "owner/repo", WorkflowName: "deploy",
+	}, WorkflowScheduleTrigger{Cron: "*/5 * * * *", Timezone: "UTC"}, time.Unix(0, 0))
+
+	declared := []WorkflowScheduleTrigger{
+		{Cron: "*/5 * * * *", Timezone: "UTC"},
+		{Cron: "0 9 * * 1-5", Timezone: "Europe/Paris"},
+	}
+
+	toCreate, toDelete := DiffSchedules(identity, declared, []HookRepositoryEvent{kept, stale, other})
+
+	if len(toCreate) != 1 || toCreate[0].Cron != "0 9 * * 1-5" {
+		t.Fatalf("expected the new Europe/Paris entry to be created, got %+v", toCreate)
+	}
+	if len(toDelete) != 1 || toDelete[0].UUID != "stale-uuid" {
+		t.Fatalf("expected the stale entry to be deleted, got %+v", toDelete)
+	}
+}
+
+func TestDiffSchedules_DedupesDuplicateDeclaredEntries(t *testing.T) {
+	identity := WorkflowScheduleIdentity{
+		ProjectKey:     "PRJ",
+		VCSServerName:  "github",
+		RepositoryName: "owner/repo",
+		WorkflowName:   "build",
+	}
+
+	declared := []WorkflowScheduleTrigger{
+		{Cron: "*/5 * * * *", Timezone: "UTC"},
+		{Cron: "*/5 * * * *", Timezone: "UTC"},
+	}
+
+	toCreate, toDelete := DiffSchedules(identity, declared, nil)
+
+	if len(toCreate) != 1 {
+		t.Fatalf("expected duplicate declared entries to collapse to one toCreate, got %+v", toCreate)
+	}
+	if len(toDelete) != 0 {
+		t.Fatalf("expected no deletions, got %+v", toDelete)
+	}
+}
+
+func TestNewScheduledFireEvent(t *testing.T) {
+	identity := WorkflowScheduleIdentity{ProjectKey: "PRJ", VCSServerName: "github", RepositoryName: "owner/repo", WorkflowName: "build"}
+	sync := NewScheduleSyncEvent("sync-uuid", identity, WorkflowScheduleTrigger{Cron: "*/5 * * * *", Timezone: "UTC"}, time.Unix(0, 0))
+
+	fired := NewScheduledFireEvent("fire-uuid", sync, time.Unix(100, 0))
+
+	if fired.EventName != WorkflowHookEventNameScheduler {
+		t.Fatalf("expected scheduler event name, got %s", fired.EventName)
+	}
+	if fired.Status != HookEventStatusScheduled {
+		t.Fatalf("expected fired event to start as Scheduled, got %s", fired.Status)
+	}
+	if fired.ExtractData.Scheduler != sync.ExtractData.Scheduler {
+		t.Fatalf("expected fired event to carry the registered schedule target, got %+v", fired.ExtractData.Scheduler)
+	}
+}
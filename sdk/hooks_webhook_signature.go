@@ -0,0 +1,89 @@
+package sdk
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"net/http"
+	"strings"
+)
+
+// ErrSignatureMismatch is returned by VerifyWebhookSignature when the inbound
+// request does not carry a valid signature for the given repository secret.
+var ErrSignatureMismatch = errors.New("webhook signature mismatch")
+
+const (
+	HeaderGithubSignature256 = "X-Hub-Signature-256"
+	HeaderGitlabToken        = "X-Gitlab-Token"
+	HeaderBitbucketSignature = "X-Hub-Signature"
+	HeaderGiteaSignature     = "X-Gitea-Signature"
+)
+
+// VerifyWebhookSignature checks that body was sent by the given VCS provider
+// for the repository that owns secret, dispatching on the header scheme each
+// provider uses. vcsType is one of "github", "gitlab", "bitbucketserver" or
+// "gitea" (case-insensitive). It returns ErrSignatureMismatch if the inbound
+// request does not carry a valid signature.
+func VerifyWebhookSignature(vcsType string, headers http.Header, body []byte, secret string) error {
+	if secret == "" {
+		return ErrSignatureMismatch
+	}
+	switch strings.ToLower(vcsType) {
+	case "github", "gitea":
+		header := HeaderGithubSignature256
+		if strings.ToLower(vcsType) == "gitea" {
+			header = HeaderGiteaSignature
+		}
+		return verifyHMACSHA256Signature(headers.Get(header), body, secret)
+	case "bitbucketserver":
+		return verifyHMACSHA256Signature(headers.Get(HeaderBitbucketSignature), body, secret)
+	case "gitlab":
+		token := headers.Get(HeaderGitlabToken)
+		if token == "" || !hmac.Equal([]byte(token), []byte(secret)) {
+			return ErrSignatureMismatch
+		}
+		return nil
+	default:
+		return ErrSignatureMismatch
+	}
+}
+
+// WebhookVerificationError wraps a VerifyWebhookSignature failure with the
+// HTTP status the hooks handler must answer with, so it can reject the
+// request with 401 before enqueueing a HookRepositoryEvent without having to
+// re-derive the status code itself.
+type WebhookVerificationError struct {
+	Err error
+}
+
+func (e *WebhookVerificationError) Error() string { return e.Err.Error() }
+func (e *WebhookVerificationError) Unwrap() error { return e.Err }
+func (e *WebhookVerificationError) StatusCode() int {
+	return http.StatusUnauthorized
+}
+
+// VerifyWebhookSignatureOrReject is the call the hooks HTTP handler makes
+// before enqueueing a HookRepositoryEvent: on a signature mismatch it
+// returns a *WebhookVerificationError, whose StatusCode() the handler writes
+// back to the VCS provider instead of enqueueing the event.
+func VerifyWebhookSignatureOrReject(vcsType string, headers http.Header, body []byte, secret string) error {
+	if err := VerifyWebhookSignature(vcsType, headers, body, secret); err != nil {
+		return &WebhookVerificationError{Err: err}
+	}
+	return nil
+}
+
+func verifyHMACSHA256Signature(header string, body []byte, secret string) error {
+	digest := strings.TrimPrefix(header, "sha256=")
+	sig, err := hex.DecodeString(digest)
+	if err != nil {
+		return ErrSignatureMismatch
+	}
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body) //nolint
+	if !hmac.Equal(sig, mac.Sum(nil)) {
+		return ErrSignatureMismatch
+	}
+	return nil
+}
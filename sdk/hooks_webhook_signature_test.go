@@ -0,0 +1,62 @@
+package sdk
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"net/http"
+	"testing"
+)
+
+func TestVerifyWebhookSignature_GitHub(t *testing.T) {
+	secret := "s3cr3t"
+	body := []byte(`{"ref":"refs/heads/main"}`)
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body) //nolint
+
+	headers := http.Header{}
+	headers.Set(HeaderGithubSignature256, "sha256="+hex.EncodeToString(mac.Sum(nil)))
+
+	if err := VerifyWebhookSignature("github", headers, body, secret); err != nil {
+		t.Fatalf("expected valid signature to verify, got %v", err)
+	}
+
+	headers.Set(HeaderGithubSignature256, "sha256=deadbeef")
+	if err := VerifyWebhookSignature("github", headers, body, secret); err != ErrSignatureMismatch {
+		t.Fatalf("expected ErrSignatureMismatch, got %v", err)
+	}
+}
+
+func TestVerifyWebhookSignature_GitlabEmptySecretNeverMatches(t *testing.T) {
+	headers := http.Header{}
+	// No X-Gitlab-Token header set: must not verify against an empty secret.
+	if err := VerifyWebhookSignature("gitlab", headers, nil, ""); err != ErrSignatureMismatch {
+		t.Fatalf("expected empty secret to be rejected, got %v", err)
+	}
+}
+
+func TestVerifyWebhookSignature_GitlabToken(t *testing.T) {
+	headers := http.Header{}
+	headers.Set(HeaderGitlabToken, "my-token")
+	if err := VerifyWebhookSignature("gitlab", headers, nil, "my-token"); err != nil {
+		t.Fatalf("expected matching token to verify, got %v", err)
+	}
+	if err := VerifyWebhookSignature("gitlab", headers, nil, "other-token"); err != ErrSignatureMismatch {
+		t.Fatalf("expected mismatched token to fail, got %v", err)
+	}
+}
+
+func TestVerifyWebhookSignatureOrReject_StatusCode(t *testing.T) {
+	err := VerifyWebhookSignatureOrReject("gitlab", http.Header{}, nil, "secret")
+	if err == nil {
+		t.Fatal("expected rejection for missing token")
+	}
+	var verrr *WebhookVerificationError
+	if !errors.As(err, &verrr) {
+		t.Fatalf("expected *WebhookVerificationError, got %T", err)
+	}
+	if verrr.StatusCode() != http.StatusUnauthorized {
+		t.Fatalf("expected 401, got %d", verrr.StatusCode())
+	}
+}
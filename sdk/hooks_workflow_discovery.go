@@ -0,0 +1,50 @@
+package sdk
+
+import (
+	"errors"
+	"strings"
+)
+
+// WorkflowSourceDirs lists the directories analysis looks for CDS workflow
+// files in, in precedence order: the first one containing at least one
+// `.yml`/`.yaml` file wins. This lets a monorepo migrate its workflows
+// gradually, or fork/inherit workflows from a `.github/cds` directory
+// without conflicting with `.cds/workflows`.
+var WorkflowSourceDirs = []string{".cds/workflows", ".cds-workflows", ".github/cds"}
+
+// ErrWorkflowDirNotFound is the contract listFn must return (or wrap, so
+// errors.Is still matches) when a candidate directory does not exist in the
+// repository. ResolveWorkflowDir treats it as "try the next candidate", not
+// as a failure -- unlike a genuine listing error (e.g. a VCS API outage),
+// which it propagates as-is. A listFn backed by os.ReadDir should translate
+// os.IsNotExist(err) into ErrWorkflowDirNotFound before returning.
+var ErrWorkflowDirNotFound = errors.New("workflow source directory not found")
+
+// ResolveWorkflowDir picks the first directory in WorkflowSourceDirs that
+// contains at least one `.yml`/`.yaml` file, listing each candidate with
+// listFn. Candidates that don't exist (listFn returning ErrWorkflowDirNotFound)
+// are skipped in favor of the next one; any other error aborts the lookup
+// and is returned as-is. It returns the chosen directory and the entries
+// listFn returned for it, or ("", nil, nil) if no candidate matched.
+func ResolveWorkflowDir(listFn func(path string) ([]string, error)) (string, []string, error) {
+	for _, dir := range WorkflowSourceDirs {
+		entries, err := listFn(dir)
+		if err != nil {
+			if errors.Is(err, ErrWorkflowDirNotFound) {
+				continue
+			}
+			return "", nil, err
+		}
+		for _, e := range entries {
+			if isYAMLFile(e) {
+				return dir, entries, nil
+			}
+		}
+	}
+	return "", nil, nil
+}
+
+func isYAMLFile(name string) bool {
+	lower := strings.ToLower(name)
+	return strings.HasSuffix(lower, ".yml") || strings.HasSuffix(lower, ".yaml")
+}
@@ -0,0 +1,52 @@
+package sdk
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestResolveWorkflowDir_FallsThroughMissingDirectories(t *testing.T) {
+	listFn := func(path string) ([]string, error) {
+		switch path {
+		case ".cds/workflows", ".cds-workflows":
+			return nil, ErrWorkflowDirNotFound
+		case ".github/cds":
+			return []string{"build.yml"}, nil
+		}
+		return nil, errors.New("unexpected path " + path)
+	}
+
+	dir, entries, err := ResolveWorkflowDir(listFn)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if dir != ".github/cds" {
+		t.Fatalf("expected to fall through to .github/cds, got %q", dir)
+	}
+	if len(entries) != 1 || entries[0] != "build.yml" {
+		t.Fatalf("unexpected entries: %v", entries)
+	}
+}
+
+func TestResolveWorkflowDir_PropagatesGenuineErrors(t *testing.T) {
+	boom := errors.New("boom")
+	listFn := func(path string) ([]string, error) {
+		return nil, boom
+	}
+
+	_, _, err := ResolveWorkflowDir(listFn)
+	if !errors.Is(err, boom) {
+		t.Fatalf("expected genuine error to propagate, got %v", err)
+	}
+}
+
+func TestResolveWorkflowDir_NoMatch(t *testing.T) {
+	listFn := func(path string) ([]string, error) {
+		return nil, ErrWorkflowDirNotFound
+	}
+
+	dir, entries, err := ResolveWorkflowDir(listFn)
+	if err != nil || dir != "" || entries != nil {
+		t.Fatalf("expected no match, got dir=%q entries=%v err=%v", dir, entries, err)
+	}
+}
@@ -0,0 +1,132 @@
+package sdk
+
+import (
+	"strings"
+	"sync"
+
+	"github.com/gobwas/glob"
+)
+
+// workflowPatternCacheMu guards workflowPatternCache, which memoizes compiled
+// globs so repeated matches against the same filter set don't recompile them.
+var (
+	workflowPatternCacheMu sync.Mutex
+	workflowPatternCache   = make(map[string]glob.Glob)
+)
+
+// WorkflowPatternFilters groups the glob filters that can gate whether a
+// HookRepositoryEventWorkflow triggers for a given push, mirroring GitHub
+// Actions' on.push.{paths,paths-ignore,branches,branches-ignore,tags,tags-ignore}.
+type WorkflowPatternFilters struct {
+	Paths          []string
+	PathsIgnore    []string
+	Branches       []string
+	BranchesIgnore []string
+	Tags           []string
+	TagsIgnore     []string
+}
+
+// MatchWorkflowPatterns reports whether the given paths and ref satisfy filters.
+// When it returns matched=false, skipReason identifies which filter rejected
+// the event ("path-filter", "branch-filter", "tag-ignore") or, if one of the
+// filters is not a valid glob, "invalid-pattern" -- filters come from
+// user-authored `.cds/workflows/*.yml` files, so a malformed one must be
+// reported rather than crash the worker handling the event.
+func MatchWorkflowPatterns(paths []string, ref string, filters WorkflowPatternFilters) (bool, string) {
+	if ignored, err := matchAnyPath(paths, filters.PathsIgnore); err != nil {
+		return false, "invalid-pattern"
+	} else if ignored {
+		return false, "path-filter"
+	}
+	if len(filters.Paths) > 0 {
+		matched, err := matchAnyPath(paths, filters.Paths)
+		if err != nil {
+			return false, "invalid-pattern"
+		}
+		if !matched {
+			return false, "path-filter"
+		}
+	}
+
+	isTag := strings.HasPrefix(ref, "refs/tags/")
+	isBranch := strings.HasPrefix(ref, "refs/heads/")
+
+	if isBranch {
+		if ignored, err := matchAnyRef(ref, filters.BranchesIgnore); err != nil {
+			return false, "invalid-pattern"
+		} else if ignored {
+			return false, "branch-filter"
+		}
+		if len(filters.Branches) > 0 {
+			matched, err := matchAnyRef(ref, filters.Branches)
+			if err != nil {
+				return false, "invalid-pattern"
+			}
+			if !matched {
+				return false, "branch-filter"
+			}
+		}
+	}
+
+	if isTag {
+		if ignored, err := matchAnyRef(ref, filters.TagsIgnore); err != nil {
+			return false, "invalid-pattern"
+		} else if ignored {
+			return false, "tag-ignore"
+		}
+		if len(filters.Tags) > 0 {
+			matched, err := matchAnyRef(ref, filters.Tags)
+			if err != nil {
+				return false, "invalid-pattern"
+			}
+			if !matched {
+				return false, "tag-ignore"
+			}
+		}
+	}
+
+	return true, ""
+}
+
+func matchAnyPath(paths []string, patterns []string) (bool, error) {
+	for _, pattern := range patterns {
+		g, err := compileWorkflowPattern(pattern)
+		if err != nil {
+			return false, err
+		}
+		for _, p := range paths {
+			if g.Match(p) {
+				return true, nil
+			}
+		}
+	}
+	return false, nil
+}
+
+func matchAnyRef(ref string, patterns []string) (bool, error) {
+	short := strings.TrimPrefix(strings.TrimPrefix(ref, "refs/heads/"), "refs/tags/")
+	for _, pattern := range patterns {
+		g, err := compileWorkflowPattern(pattern)
+		if err != nil {
+			return false, err
+		}
+		if g.Match(ref) || g.Match(short) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func compileWorkflowPattern(pattern string) (glob.Glob, error) {
+	workflowPatternCacheMu.Lock()
+	defer workflowPatternCacheMu.Unlock()
+	if g, ok := workflowPatternCache[pattern]; ok {
+		return g, nil
+	}
+	g, err := glob.Compile(pattern, '/')
+	if err != nil {
+		return nil, err
+	}
+	workflowPatternCache[pattern] = g
+	return g, nil
+}
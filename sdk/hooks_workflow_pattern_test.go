@@ -0,0 +1,43 @@
+package sdk
+
+import "testing"
+
+func TestMatchWorkflowPatterns_PathFilter(t *testing.T) {
+	matched, reason := MatchWorkflowPatterns([]string{"api/handler.go"}, "refs/heads/main", WorkflowPatternFilters{
+		Paths: []string{"api/**"},
+	})
+	if !matched || reason != "" {
+		t.Fatalf("expected match, got matched=%v reason=%q", matched, reason)
+	}
+
+	matched, reason = MatchWorkflowPatterns([]string{"docs/readme.md"}, "refs/heads/main", WorkflowPatternFilters{
+		Paths: []string{"api/**"},
+	})
+	if matched || reason != "path-filter" {
+		t.Fatalf("expected path-filter skip, got matched=%v reason=%q", matched, reason)
+	}
+}
+
+func TestMatchWorkflowPatterns_BranchIgnore(t *testing.T) {
+	matched, reason := MatchWorkflowPatterns(nil, "refs/heads/release/1.0", WorkflowPatternFilters{
+		BranchesIgnore: []string{"release/**"},
+	})
+	if matched || reason != "branch-filter" {
+		t.Fatalf("expected branch-filter skip, got matched=%v reason=%q", matched, reason)
+	}
+}
+
+func TestMatchWorkflowPatterns_InvalidPatternDoesNotPanic(t *testing.T) {
+	defer func() {
+		if r := recover(); r != nil {
+			t.Fatalf("MatchWorkflowPatterns panicked on an invalid glob: %v", r)
+		}
+	}()
+
+	matched, reason := MatchWorkflowPatterns([]string{"api/handler.go"}, "refs/heads/main", WorkflowPatternFilters{
+		Paths: []string{"api/["},
+	})
+	if matched || reason != "invalid-pattern" {
+		t.Fatalf("expected invalid-pattern skip, got matched=%v reason=%q", matched, reason)
+	}
+}